@@ -0,0 +1,168 @@
+package client
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned when a request is rejected because the
+// circuit breaker for its host is open.
+var ErrCircuitOpen = errors.New("client: circuit breaker is open")
+
+type breakerState int
+
+const (
+	breakerClosed breakerState = iota
+	breakerOpen
+	breakerHalfOpen
+)
+
+// CircuitBreakerConfig configures the per-host circuit breaker.
+type CircuitBreakerConfig struct {
+	// WindowSize is the number of most recent requests used to compute
+	// the rolling error rate.
+	WindowSize int
+	// ErrorThreshold is the error ratio (0..1) over WindowSize that
+	// trips the breaker open.
+	ErrorThreshold float64
+	// Cooldown is how long the breaker stays open before allowing a
+	// single half-open probe request.
+	Cooldown time.Duration
+}
+
+// DefaultCircuitBreakerConfig trips after 50% errors over the last 20
+// requests and cools down for 30 seconds before probing again.
+func DefaultCircuitBreakerConfig() CircuitBreakerConfig {
+	return CircuitBreakerConfig{
+		WindowSize:     20,
+		ErrorThreshold: 0.5,
+		Cooldown:       30 * time.Second,
+	}
+}
+
+// circuitBreaker implements a per-host closed/open/half-open breaker
+// over a rolling window of outcomes.
+type circuitBreaker struct {
+	cfg CircuitBreakerConfig
+
+	mu         sync.Mutex
+	state      breakerState
+	outcomes   []bool // true = success
+	openedAt   time.Time
+	probeInUse bool
+}
+
+func newCircuitBreaker(cfg CircuitBreakerConfig) *circuitBreaker {
+	return &circuitBreaker{cfg: cfg}
+}
+
+// Allow reports whether a request may proceed, transitioning open ->
+// half-open once the cooldown elapses.
+func (b *circuitBreaker) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case breakerOpen:
+		if time.Since(b.openedAt) < b.cfg.Cooldown {
+			return false
+		}
+		b.state = breakerHalfOpen
+		b.probeInUse = true
+		return true
+	case breakerHalfOpen:
+		return !b.probeInUse
+	default:
+		return true
+	}
+}
+
+// RecordSuccess records a successful call, closing the breaker if it
+// was probing in the half-open state.
+func (b *circuitBreaker) RecordSuccess() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == breakerHalfOpen {
+		b.reset()
+		return
+	}
+	b.record(true)
+}
+
+// RecordFailure records a failed call, tripping the breaker open when
+// the rolling error rate exceeds the configured threshold, or
+// reopening it immediately if the half-open probe failed.
+func (b *circuitBreaker) RecordFailure() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == breakerHalfOpen {
+		b.trip()
+		return true
+	}
+
+	b.record(false)
+	if b.errorRate() > b.cfg.ErrorThreshold {
+		b.trip()
+		return true
+	}
+	return false
+}
+
+func (b *circuitBreaker) record(success bool) {
+	b.outcomes = append(b.outcomes, success)
+	if len(b.outcomes) > b.cfg.WindowSize {
+		b.outcomes = b.outcomes[len(b.outcomes)-b.cfg.WindowSize:]
+	}
+}
+
+func (b *circuitBreaker) errorRate() float64 {
+	if len(b.outcomes) < b.cfg.WindowSize {
+		return 0
+	}
+	failures := 0
+	for _, ok := range b.outcomes {
+		if !ok {
+			failures++
+		}
+	}
+	return float64(failures) / float64(len(b.outcomes))
+}
+
+func (b *circuitBreaker) trip() {
+	b.state = breakerOpen
+	b.openedAt = time.Now()
+	b.probeInUse = false
+	b.outcomes = nil
+}
+
+func (b *circuitBreaker) reset() {
+	b.state = breakerClosed
+	b.probeInUse = false
+	b.outcomes = nil
+}
+
+// breakerRegistry lazily creates one circuitBreaker per host.
+type breakerRegistry struct {
+	cfg      CircuitBreakerConfig
+	mu       sync.Mutex
+	breakers map[string]*circuitBreaker
+}
+
+func newBreakerRegistry(cfg CircuitBreakerConfig) *breakerRegistry {
+	return &breakerRegistry{cfg: cfg, breakers: make(map[string]*circuitBreaker)}
+}
+
+func (r *breakerRegistry) forHost(host string) *circuitBreaker {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	b, ok := r.breakers[host]
+	if !ok {
+		b = newCircuitBreaker(r.cfg)
+		r.breakers[host] = b
+	}
+	return b
+}
@@ -0,0 +1,68 @@
+package client
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCircuitBreakerTripsAndRecovers(t *testing.T) {
+	cfg := CircuitBreakerConfig{WindowSize: 4, ErrorThreshold: 0.5, Cooldown: 20 * time.Millisecond}
+	b := newCircuitBreaker(cfg)
+
+	if !b.Allow() {
+		t.Fatal("breaker should start closed")
+	}
+
+	for i := 0; i < 4; i++ {
+		b.RecordFailure()
+	}
+	if b.Allow() {
+		t.Fatal("breaker should be open after the error rate exceeded the threshold")
+	}
+
+	// Once the cooldown elapses, the next Allow() transitions to
+	// half-open and lets exactly one probe through.
+	time.Sleep(cfg.Cooldown * 2)
+	if !b.Allow() {
+		t.Fatal("breaker should allow a half-open probe once cooldown elapses")
+	}
+	if b.Allow() {
+		t.Fatal("breaker should reject a second concurrent half-open probe")
+	}
+
+	b.RecordSuccess()
+	if !b.Allow() {
+		t.Fatal("breaker should close again after a successful probe")
+	}
+}
+
+func TestCircuitBreakerReopensOnFailedProbe(t *testing.T) {
+	cfg := CircuitBreakerConfig{WindowSize: 2, ErrorThreshold: 0.5, Cooldown: 50 * time.Millisecond}
+	b := newCircuitBreaker(cfg)
+
+	b.RecordFailure()
+	b.RecordFailure()
+	if b.Allow() {
+		t.Fatal("breaker should be open")
+	}
+
+	time.Sleep(60 * time.Millisecond)
+	b.Allow() // transition to half-open, consume the probe slot
+	b.RecordFailure()
+	if b.Allow() {
+		t.Fatal("breaker should stay open immediately after a failed half-open probe")
+	}
+}
+
+func TestBreakerRegistryIsPerHost(t *testing.T) {
+	r := newBreakerRegistry(DefaultCircuitBreakerConfig())
+
+	a := r.forHost("host-a")
+	b := r.forHost("host-b")
+	if a == b {
+		t.Fatal("expected distinct breakers per host")
+	}
+	if r.forHost("host-a") != a {
+		t.Fatal("expected the same breaker to be reused for a repeated host")
+	}
+}
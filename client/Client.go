@@ -16,36 +16,106 @@ type Client interface {
 	Put(ctx context.Context, path string, body interface{}, result interface{}) error
 	Delete(ctx context.Context, path string) error
 	Download(ctx context.Context, path string, writer io.Writer) error
+	// Stream consumes a Server-Sent Events endpoint at path, invoking
+	// onEvent for every event received. It automatically reconnects
+	// using the Last-Event-ID header until ctx is canceled.
+	Stream(ctx context.Context, path string, onEvent func(event string, data []byte)) error
 }
 
 type client struct {
-	resty  *resty.Client
-	config *Config
+	resty      *resty.Client
+	streamHTTP *http.Client
+	config     *Config
+	breakers   *breakerRegistry
+	hedging    *HedgingConfig
+	metrics    Metrics
 }
 
-// NewClient initializes and returns a new Client instance.
+// NewClient initializes and returns a new Client instance. By default
+// it retries using Config's RetryCount/RetryWait; pass WithRetryPolicy,
+// WithCircuitBreaker and/or WithHedging to opt into the resilience
+// pipeline.
 //
 // Example:
 //
 //	cfg := config.NewClientConfig()
-//	cli := client.NewClient(cfg)
+//	cli := client.NewClient(cfg,
+//	    client.WithRetryPolicy(client.DefaultRetryPolicy()),
+//	    client.WithCircuitBreaker(client.DefaultCircuitBreakerConfig()),
+//	)
 //	var data ResponseStruct
 //	err := cli.Get(ctx, "/api/v1/resource", &data)
 //	if err != nil {
 //	    log.Fatal(err)
 //	}
-func NewClient(cfg *Config) Client {
+func NewClient(cfg *Config, opts ...Option) Client {
+	o := &options{metrics: NoopMetrics{}}
+	for _, opt := range opts {
+		opt(o)
+	}
+
 	r := resty.New().
 		SetBaseURL(cfg.BaseURL).
 		SetTimeout(cfg.Timeout).
-		SetRetryCount(cfg.RetryCount).
-		SetRetryWaitTime(cfg.RetryWait).
 		SetHeaders(cfg.Headers)
 
-	return &client{
-		resty:  r,
-		config: cfg,
+	if o.retryPolicy != nil {
+		o.retryPolicy.apply(r, o.metrics)
+	} else {
+		r.SetRetryCount(cfg.RetryCount).SetRetryWaitTime(cfg.RetryWait)
+	}
+
+	c := &client{
+		resty: r,
+		// streamHTTP shares the configured transport but, unlike resty's
+		// client, carries no Timeout: http.Client.Timeout bounds the
+		// whole request including body reads, which would force-close
+		// every long-lived SSE stream at cfg.Timeout. Streams are
+		// instead bounded only by the caller's context.
+		streamHTTP: &http.Client{Transport: r.GetClient().Transport},
+		config:     cfg,
+		hedging:    o.hedging,
+		metrics:    o.metrics,
 	}
+
+	if o.circuitBreaker != nil {
+		c.breakers = newBreakerRegistry(*o.circuitBreaker)
+	}
+
+	return c
+}
+
+// execute runs do, routing it through the circuit breaker and hedging
+// pipeline when configured, and reports the outcome to the breaker so
+// its rolling error rate stays current.
+func (c *client) execute(ctx context.Context, do func(ctx context.Context) (*resty.Response, error)) (*resty.Response, error) {
+	var breaker *circuitBreaker
+	if c.breakers != nil {
+		breaker = c.breakers.forHost(c.config.BaseURL)
+		if !breaker.Allow() {
+			return nil, ErrCircuitOpen
+		}
+	}
+
+	var resp *resty.Response
+	var err error
+	if c.hedging != nil {
+		resp, err = hedged(ctx, *c.hedging, c.metrics, c.config.BaseURL, do)
+	} else {
+		resp, err = do(ctx)
+	}
+
+	if breaker != nil {
+		if err != nil || resp.IsError() {
+			if breaker.RecordFailure() {
+				c.metrics.IncBreakerTrip(c.config.BaseURL)
+			}
+		} else {
+			breaker.RecordSuccess()
+		}
+	}
+
+	return resp, err
 }
 
 // Get sends a GET request and unmarshals the response.
@@ -58,9 +128,9 @@ func NewClient(cfg *Config) Client {
 //	    log.Fatal(err)
 //	}
 func (c *client) Get(ctx context.Context, path string, result interface{}) error {
-	resp, err := c.resty.R().
-		SetContext(ctx).
-		Get(path)
+	resp, err := c.execute(ctx, func(ctx context.Context) (*resty.Response, error) {
+		return c.resty.R().SetContext(ctx).Get(path)
+	})
 	if err != nil {
 		return err
 	}
@@ -83,10 +153,9 @@ func (c *client) Get(ctx context.Context, path string, result interface{}) error
 //	    log.Fatal(err)
 //	}
 func (c *client) Post(ctx context.Context, path string, body interface{}, result interface{}) error {
-	resp, err := c.resty.R().
-		SetContext(ctx).
-		SetBody(body).
-		Post(path)
+	resp, err := c.execute(ctx, func(ctx context.Context) (*resty.Response, error) {
+		return c.resty.R().SetContext(ctx).SetBody(body).Post(path)
+	})
 	if err != nil {
 		return err
 	}
@@ -109,10 +178,9 @@ func (c *client) Post(ctx context.Context, path string, body interface{}, result
 //	    log.Fatal(err)
 //	}
 func (c *client) Put(ctx context.Context, path string, body interface{}, result interface{}) error {
-	resp, err := c.resty.R().
-		SetContext(ctx).
-		SetBody(body).
-		Put(path)
+	resp, err := c.execute(ctx, func(ctx context.Context) (*resty.Response, error) {
+		return c.resty.R().SetContext(ctx).SetBody(body).Put(path)
+	})
 	if err != nil {
 		return err
 	}
@@ -133,9 +201,9 @@ func (c *client) Put(ctx context.Context, path string, body interface{}, result
 //	    log.Fatal(err)
 //	}
 func (c *client) Delete(ctx context.Context, path string) error {
-	resp, err := c.resty.R().
-		SetContext(ctx).
-		Delete(path)
+	resp, err := c.execute(ctx, func(ctx context.Context) (*resty.Response, error) {
+		return c.resty.R().SetContext(ctx).Delete(path)
+	})
 	if err != nil {
 		return err
 	}
@@ -158,10 +226,9 @@ func (c *client) Delete(ctx context.Context, path string) error {
 //	    log.Fatal(err)
 //	}
 func (c *client) Download(ctx context.Context, path string, writer io.Writer) error {
-	resp, err := c.resty.R().
-		SetContext(ctx).
-		SetDoNotParseResponse(true).
-		Get(path)
+	resp, err := c.execute(ctx, func(ctx context.Context) (*resty.Response, error) {
+		return c.resty.R().SetContext(ctx).SetDoNotParseResponse(true).Get(path)
+	})
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// HedgingConfig enables request hedging: a second, identical attempt is
+// fired after Delay if the first hasn't completed yet, and whichever
+// attempt finishes first wins; the other is canceled.
+type HedgingConfig struct {
+	// Delay is typically set to the endpoint's observed P99 latency.
+	Delay time.Duration
+}
+
+type hedgeResult struct {
+	resp   *resty.Response
+	err    error
+	hedged bool
+}
+
+// hedged runs do twice - once immediately and once after cfg.Delay
+// unless the first attempt has already completed - returning whichever
+// result comes back first and canceling the loser via context.
+func hedged(ctx context.Context, cfg HedgingConfig, metrics Metrics, host string, do func(ctx context.Context) (*resty.Response, error)) (*resty.Response, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make(chan hedgeResult, 2)
+
+	launch := func(isHedge bool) {
+		resp, err := do(ctx)
+		results <- hedgeResult{resp: resp, err: err, hedged: isHedge}
+	}
+
+	go launch(false)
+
+	timer := time.NewTimer(cfg.Delay)
+	defer timer.Stop()
+
+	select {
+	case res := <-results:
+		return res.resp, res.err
+	case <-timer.C:
+		go launch(true)
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	first := <-results
+	cancel()
+	if first.hedged {
+		metrics.IncHedgedWin(host)
+	}
+	return first.resp, first.err
+}
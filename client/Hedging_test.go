@@ -0,0 +1,61 @@
+package client
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+type hedgeWinCounter struct {
+	NoopMetrics
+	wins int
+}
+
+func (m *hedgeWinCounter) IncHedgedWin(host string) { m.wins++ }
+
+func TestHedgedDoesNotCountWinWhenOriginalBeatsHedge(t *testing.T) {
+	cfg := HedgingConfig{Delay: 20 * time.Millisecond}
+	metrics := &hedgeWinCounter{}
+
+	do := func(ctx context.Context) (*resty.Response, error) {
+		// Slower than the hedge delay so a second attempt is launched,
+		// but still faster than a second round trip would be, so the
+		// original is the one that actually returns.
+		time.Sleep(30 * time.Millisecond)
+		return &resty.Response{}, nil
+	}
+
+	if _, err := hedged(context.Background(), cfg, metrics, "host", do); err != nil {
+		t.Fatalf("hedged: %v", err)
+	}
+	if metrics.wins != 0 {
+		t.Fatalf("expected no hedged win to be counted, got %d", metrics.wins)
+	}
+}
+
+func TestHedgedCountsWinWhenOriginalNeverReturns(t *testing.T) {
+	cfg := HedgingConfig{Delay: 10 * time.Millisecond}
+	metrics := &hedgeWinCounter{}
+
+	var calls int32
+	do := func(ctx context.Context) (*resty.Response, error) {
+		n := atomic.AddInt32(&calls, 1) - 1
+		if n == 0 {
+			// First (original) attempt: block until canceled so it
+			// never wins the race.
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}
+		return &resty.Response{}, nil
+	}
+
+	if _, err := hedged(context.Background(), cfg, metrics, "host", do); err != nil {
+		t.Fatalf("hedged: %v", err)
+	}
+	if metrics.wins != 1 {
+		t.Fatalf("expected exactly one hedged win to be counted, got %d", metrics.wins)
+	}
+}
@@ -0,0 +1,17 @@
+package client
+
+// Metrics receives counters for the resilience pipeline so callers can
+// wire them up to Prometheus or any other backend.
+type Metrics interface {
+	IncRetry(host string)
+	IncBreakerTrip(host string)
+	IncHedgedWin(host string)
+}
+
+// NoopMetrics discards all counters. It is the default used when no
+// Metrics implementation is supplied via WithMetrics.
+type NoopMetrics struct{}
+
+func (NoopMetrics) IncRetry(host string)       {}
+func (NoopMetrics) IncBreakerTrip(host string) {}
+func (NoopMetrics) IncHedgedWin(host string)   {}
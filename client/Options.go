@@ -0,0 +1,40 @@
+package client
+
+// Option configures optional resilience behavior on NewClient: retry
+// policy, circuit breaker and request hedging are all opt-in so the
+// default client keeps behaving like Config's RetryCount/RetryWait.
+type Option func(*options)
+
+type options struct {
+	retryPolicy    *RetryPolicy
+	circuitBreaker *CircuitBreakerConfig
+	hedging        *HedgingConfig
+	metrics        Metrics
+}
+
+// WithRetryPolicy replaces Config's fixed RetryCount/RetryWait with a
+// policy that backs off exponentially with jitter and only retries
+// requests the policy's ShouldRetry predicate allows.
+func WithRetryPolicy(policy *RetryPolicy) Option {
+	return func(o *options) { o.retryPolicy = policy }
+}
+
+// WithCircuitBreaker enables a per-host circuit breaker, rejecting
+// requests with ErrCircuitOpen while the breaker is open.
+func WithCircuitBreaker(cfg CircuitBreakerConfig) Option {
+	return func(o *options) { o.circuitBreaker = &cfg }
+}
+
+// WithHedging enables request hedging: a second attempt fires after
+// cfg.Delay if the first hasn't completed, and whichever finishes
+// first wins.
+func WithHedging(cfg HedgingConfig) Option {
+	return func(o *options) { o.hedging = &cfg }
+}
+
+// WithMetrics wires counters for retries, breaker trips and hedged
+// wins into the given Metrics implementation, e.g. a Prometheus
+// collector. Defaults to NoopMetrics.
+func WithMetrics(m Metrics) Option {
+	return func(o *options) { o.metrics = m }
+}
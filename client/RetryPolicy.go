@@ -0,0 +1,80 @@
+package client
+
+import (
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// RetryPolicy configures exponential backoff with jitter and decides,
+// per response, whether a request should be retried.
+type RetryPolicy struct {
+	MaxRetries int
+	BaseDelay  time.Duration
+	MaxDelay   time.Duration
+
+	// ShouldRetry decides whether to retry based on the response and/or
+	// transport error. Defaults to retrying on 5xx, 429 and connection
+	// errors when unset.
+	ShouldRetry func(resp *resty.Response, err error) bool
+}
+
+// DefaultRetryPolicy retries on 5xx, 429 and connection errors using
+// exponential backoff with jitter, honoring Retry-After when present.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxRetries:  3,
+		BaseDelay:   200 * time.Millisecond,
+		MaxDelay:    5 * time.Second,
+		ShouldRetry: defaultShouldRetry,
+	}
+}
+
+func defaultShouldRetry(resp *resty.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	status := resp.StatusCode()
+	return status == http.StatusTooManyRequests || status >= http.StatusInternalServerError
+}
+
+// backoff computes the delay before the next attempt, honoring
+// Retry-After on the response when present and falling back to
+// exponential backoff with full jitter otherwise.
+func (p *RetryPolicy) backoff(resp *resty.Response) time.Duration {
+	if resp != nil {
+		if ra := resp.Header().Get("Retry-After"); ra != "" {
+			if secs, err := strconv.Atoi(ra); err == nil {
+				return time.Duration(secs) * time.Second
+			}
+		}
+	}
+
+	delay := time.Duration(float64(p.BaseDelay) * math.Pow(2, float64(resp.Request.Attempt-1)))
+	if delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	return time.Duration(rand.Int63n(int64(delay) + 1))
+}
+
+// apply wires the policy into resty as a retry condition plus a
+// backoff function, notifying metrics on every retry attempt.
+func (p *RetryPolicy) apply(r *resty.Client, metrics Metrics) {
+	r.SetRetryCount(p.MaxRetries)
+	r.SetRetryMaxWaitTime(p.MaxDelay)
+	r.SetRetryAfter(func(c *resty.Client, resp *resty.Response) (time.Duration, error) {
+		metrics.IncRetry(c.BaseURL)
+		return p.backoff(resp), nil
+	})
+	shouldRetry := p.ShouldRetry
+	if shouldRetry == nil {
+		shouldRetry = defaultShouldRetry
+	}
+	r.AddRetryCondition(func(resp *resty.Response, err error) bool {
+		return shouldRetry(resp, err)
+	})
+}
@@ -0,0 +1,12 @@
+package client
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDefaultShouldRetryOnTransportError(t *testing.T) {
+	if !defaultShouldRetry(nil, errors.New("connection refused")) {
+		t.Fatal("expected a transport error to be retried regardless of response")
+	}
+}
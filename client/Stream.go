@@ -0,0 +1,84 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// reconnectDelay is how long Stream waits before reconnecting after
+// the connection drops.
+const reconnectDelay = time.Second
+
+// Stream consumes a Server-Sent Events endpoint at path, invoking
+// onEvent for every event received. It reconnects automatically when
+// the connection drops, sending the last received event's id back via
+// the Last-Event-ID header, and returns only when ctx is canceled.
+//
+// Example:
+//
+//	err := cli.Stream(ctx, "/stream/room-1", func(event string, data []byte) {
+//	    fmt.Println(event, string(data))
+//	})
+func (c *client) Stream(ctx context.Context, path string, onEvent func(event string, data []byte)) error {
+	var lastEventID string
+
+	for {
+		_ = c.streamOnce(ctx, path, &lastEventID, onEvent)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(reconnectDelay):
+		}
+	}
+}
+
+func (c *client) streamOnce(ctx context.Context, path string, lastEventID *string, onEvent func(event string, data []byte)) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.config.BaseURL+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	if *lastEventID != "" {
+		req.Header.Set("Last-Event-ID", *lastEventID)
+	}
+
+	resp, err := c.streamHTTP.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var event string
+	var data strings.Builder
+
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "event:"):
+			event = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case strings.HasPrefix(line, "data:"):
+			if data.Len() > 0 {
+				data.WriteByte('\n')
+			}
+			data.WriteString(strings.TrimSpace(strings.TrimPrefix(line, "data:")))
+		case strings.HasPrefix(line, "id:"):
+			*lastEventID = strings.TrimSpace(strings.TrimPrefix(line, "id:"))
+		case line == "":
+			if data.Len() > 0 {
+				onEvent(event, []byte(data.String()))
+			}
+			event = ""
+			data.Reset()
+		}
+	}
+
+	return scanner.Err()
+}
@@ -0,0 +1,153 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestStreamOutlivesConfiguredTimeout proves the SSE stream isn't cut
+// short by Config.Timeout: the server deliberately pauses longer than
+// Timeout between events, which a client.resty-bound http.Client would
+// have aborted mid-stream.
+func TestStreamOutlivesConfiguredTimeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		flusher := w.(http.Flusher)
+
+		fmt.Fprint(w, "data: one\n\n")
+		flusher.Flush()
+
+		time.Sleep(80 * time.Millisecond)
+
+		fmt.Fprint(w, "data: two\n\n")
+		flusher.Flush()
+	}))
+	defer srv.Close()
+
+	cli := NewClient(&Config{BaseURL: srv.URL, Timeout: 30 * time.Millisecond})
+
+	type evt struct {
+		event string
+		data  string
+	}
+	events := make(chan evt, 2)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- cli.Stream(ctx, "/stream", func(event string, data []byte) {
+			events <- evt{event: event, data: string(data)}
+		})
+	}()
+
+	var got []string
+	for i := 0; i < 2; i++ {
+		select {
+		case e := <-events:
+			got = append(got, e.data)
+		case <-time.After(500 * time.Millisecond):
+			t.Fatalf("timed out waiting for event %d, got so far: %v", i, got)
+		}
+	}
+
+	if got[0] != "one" || got[1] != "two" {
+		t.Fatalf("got events %v, want [one two]", got)
+	}
+
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Fatalf("Stream returned %v, want context.Canceled", err)
+	}
+}
+
+// TestStreamReconnectsWithLastEventID proves that when the connection
+// drops after an id-tagged event, Stream reconnects and resumes by
+// sending the last event id back via the Last-Event-ID header, as a
+// spec-compliant SSE server expects.
+func TestStreamReconnectsWithLastEventID(t *testing.T) {
+	var connections atomic.Int32
+	lastEventIDSeen := make(chan string, 2)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lastEventIDSeen <- r.Header.Get("Last-Event-ID")
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		flusher := w.(http.Flusher)
+
+		if connections.Add(1) == 1 {
+			fmt.Fprint(w, "id: 42\ndata: one\n\n")
+			flusher.Flush()
+			return // drop the connection; the client should reconnect
+		}
+
+		fmt.Fprint(w, "data: two\n\n")
+		flusher.Flush()
+	}))
+	defer srv.Close()
+
+	cli := NewClient(&Config{BaseURL: srv.URL, Timeout: 30 * time.Millisecond})
+
+	type evt struct {
+		event string
+		data  string
+	}
+	events := make(chan evt, 2)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- cli.Stream(ctx, "/stream", func(event string, data []byte) {
+			events <- evt{event: event, data: string(data)}
+		})
+	}()
+
+	select {
+	case first := <-lastEventIDSeen:
+		if first != "" {
+			t.Fatalf("first connection Last-Event-ID = %q, want empty", first)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for the first connection")
+	}
+
+	select {
+	case e := <-events:
+		if e.data != "one" {
+			t.Fatalf("got event data %q, want %q", e.data, "one")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for the first event")
+	}
+
+	select {
+	case second := <-lastEventIDSeen:
+		if second != "42" {
+			t.Fatalf("reconnect Last-Event-ID = %q, want %q", second, "42")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for the reconnect")
+	}
+
+	select {
+	case e := <-events:
+		if e.data != "two" {
+			t.Fatalf("got event data %q, want %q", e.data, "two")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for the second event")
+	}
+
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Fatalf("Stream returned %v, want context.Canceled", err)
+	}
+}
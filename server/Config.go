@@ -8,6 +8,15 @@ type Config struct {
 	Host string `yaml:"host"`
 	Port string `yaml:"port"`
 	Mode string `yaml:"mode"`
+
+	// GRPC enables a gRPC server alongside the HTTP server when set.
+	GRPC *GRPCConfig `yaml:"grpc"`
+
+	// DefaultMiddleware selects the engine-wide middleware stack
+	// installed by NewServer, in order. Recognized values are
+	// "logger", "recovery" and "request_id". Leave nil to keep the
+	// historical gin.Logger()+gin.Recovery() default.
+	DefaultMiddleware []string `yaml:"default_middleware"`
 }
 
 func NewConfig() *Config {
@@ -0,0 +1,32 @@
+package server
+
+import (
+	"google.golang.org/grpc"
+)
+
+// GRPCConfig holds configuration for the optional gRPC endpoint exposed
+// alongside the HTTP server.
+type GRPCConfig struct {
+	Host        string `yaml:"host"`
+	Port        string `yaml:"port"`
+	TLSCertFile string `yaml:"tls_cert_file"`
+	TLSKeyFile  string `yaml:"tls_key_file"`
+
+	// ServerOptions are passed through to grpc.NewServer, e.g. interceptors
+	// or keepalive policies.
+	ServerOptions []grpc.ServerOption `yaml:"-"`
+}
+
+// NewGRPCConfig builds a GRPCConfig from environment variables, falling
+// back to sensible defaults when unset.
+func NewGRPCConfig() *GRPCConfig {
+	return &GRPCConfig{
+		Host: getEnv("GRPC_HOST", "localhost"),
+		Port: getEnv("GRPC_PORT", "9090"),
+	}
+}
+
+// GetAddr returns the host:port the gRPC server listens on.
+func (c *GRPCConfig) GetAddr() string {
+	return c.Host + ":" + c.Port
+}
@@ -0,0 +1,101 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+var validate = validator.New()
+
+// HTTPError lets a typed Handler control the status code a returned
+// error is reported with, instead of always falling back to 500.
+type HTTPError struct {
+	Status int
+	Err    error
+}
+
+func (e *HTTPError) Error() string { return e.Err.Error() }
+func (e *HTTPError) Unwrap() error { return e.Err }
+
+// NewHTTPError wraps err so a typed Handler reports it with status
+// instead of http.StatusInternalServerError.
+//
+// Example:
+//
+//	return Resp{}, server.NewHTTPError(http.StatusNotFound, ErrUserNotFound)
+func NewHTTPError(status int, err error) *HTTPError {
+	return &HTTPError{Status: status, Err: err}
+}
+
+// Handler adapts a typed func(ctx, Req) (Resp, error) into a
+// gin.HandlerFunc: it binds Req from the request's path/query/header/
+// body via struct tags, validates it, calls fn, and writes Resp (or
+// the mapped error) as JSON.
+//
+// Example:
+//
+//	type GetUserReq struct {
+//	    ID string `uri:"id" validate:"required"`
+//	}
+//
+//	server.RouteConfig{
+//	    Path:       "/users/:id",
+//	    Method:     http.MethodGet,
+//	    HandleFunc: server.Handler(userHandler.GetUser),
+//	}
+func Handler[Req, Resp any](fn func(ctx context.Context, req Req) (Resp, error)) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req Req
+		if err := bindRequest(c, &req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		if err := validate.Struct(req); err != nil {
+			var invalid *validator.InvalidValidationError
+			if !errors.As(err, &invalid) {
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+		}
+
+		resp, err := fn(c.Request.Context(), req)
+		if err != nil {
+			status := http.StatusInternalServerError
+			var httpErr *HTTPError
+			if errors.As(err, &httpErr) {
+				status = httpErr.Status
+				err = httpErr.Err
+			}
+			c.JSON(status, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// bindRequest binds the uri, query, header and (when present) JSON
+// body parameters of c onto req in turn.
+func bindRequest(c *gin.Context, req any) error {
+	if err := c.ShouldBindUri(req); err != nil {
+		return err
+	}
+	if err := c.ShouldBindQuery(req); err != nil {
+		return err
+	}
+	if err := c.ShouldBindHeader(req); err != nil {
+		return err
+	}
+	if c.Request.Body != nil && c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(req); err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+	}
+	return nil
+}
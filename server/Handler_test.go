@@ -0,0 +1,60 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+type greetReq struct {
+	Name string `uri:"name" validate:"required"`
+}
+
+type greetResp struct {
+	Message string `json:"message"`
+}
+
+var errUserNotFound = errors.New("user not found")
+
+func TestHandlerBindsValidatesAndEncodes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	engine.GET("/greet/:name", Handler(func(ctx context.Context, req greetReq) (greetResp, error) {
+		return greetResp{Message: "hello " + req.Name}, nil
+	}))
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/greet/ada", nil)
+	engine.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if !strings.Contains(rec.Body.String(), "hello ada") {
+		t.Fatalf("body = %q, want it to contain %q", rec.Body.String(), "hello ada")
+	}
+}
+
+func TestHandlerMapsHTTPError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	engine.GET("/greet/:name", Handler(func(ctx context.Context, req greetReq) (greetResp, error) {
+		return greetResp{}, NewHTTPError(http.StatusNotFound, errUserNotFound)
+	}))
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/greet/ada", nil)
+	engine.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+	if !strings.Contains(rec.Body.String(), errUserNotFound.Error()) {
+		t.Fatalf("body = %q, want it to contain %q", rec.Body.String(), errUserNotFound.Error())
+	}
+}
@@ -0,0 +1,189 @@
+package server
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+type openAPIDoc struct {
+	OpenAPI string              `json:"openapi"`
+	Info    openAPIInfo         `json:"info"`
+	Paths   map[string]pathItem `json:"paths"`
+}
+
+type openAPIInfo struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+type pathItem map[string]operation
+
+type operation struct {
+	Summary     string              `json:"summary,omitempty"`
+	Tags        []string            `json:"tags,omitempty"`
+	RequestBody *requestBody        `json:"requestBody,omitempty"`
+	Responses   map[string]response `json:"responses"`
+}
+
+type requestBody struct {
+	Content map[string]mediaType `json:"content"`
+}
+
+type response struct {
+	Description string               `json:"description"`
+	Content     map[string]mediaType `json:"content,omitempty"`
+}
+
+type mediaType struct {
+	Schema any `json:"schema"`
+}
+
+// GenerateOpenAPI builds an OpenAPI 3.1 document from routes, deriving
+// request/response JSON schemas from RequestExample/ResponseExample.
+//
+// Example:
+//
+//	spec, err := server.GenerateOpenAPI(routes)
+func GenerateOpenAPI(routes []RouteConfig) ([]byte, error) {
+	doc := openAPIDoc{
+		OpenAPI: "3.1.0",
+		Info:    openAPIInfo{Title: "API", Version: "1.0.0"},
+		Paths:   make(map[string]pathItem),
+	}
+
+	for _, r := range routes {
+		path := toOpenAPIPath(r.Path)
+		item, ok := doc.Paths[path]
+		if !ok {
+			item = make(pathItem)
+		}
+
+		op := operation{
+			Summary: r.Summary,
+			Tags:    r.Tags,
+			Responses: map[string]response{
+				"200": {Description: "OK"},
+			},
+		}
+
+		if r.ResponseExample != nil {
+			op.Responses["200"] = response{
+				Description: "OK",
+				Content: map[string]mediaType{
+					"application/json": {Schema: schemaFor(r.ResponseExample)},
+				},
+			}
+		}
+
+		if r.RequestExample != nil {
+			op.RequestBody = &requestBody{
+				Content: map[string]mediaType{
+					"application/json": {Schema: schemaFor(r.RequestExample)},
+				},
+			}
+		}
+
+		for err, status := range r.ErrorStatus {
+			op.Responses[strconv.Itoa(status)] = response{Description: err.Error()}
+		}
+
+		item[strings.ToLower(r.Method)] = op
+		doc.Paths[path] = item
+	}
+
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+// toOpenAPIPath rewrites Gin's :param path syntax into OpenAPI's
+// {param} syntax.
+func toOpenAPIPath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, s := range segments {
+		if strings.HasPrefix(s, ":") {
+			segments[i] = "{" + s[1:] + "}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// schemaFor reflects over v to build a JSON Schema object, supporting
+// nested structs, slices and primitive types.
+func schemaFor(v any) map[string]any {
+	return schemaForType(reflect.TypeOf(v))
+}
+
+func schemaForType(t reflect.Type) map[string]any {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		props := make(map[string]any)
+		var required []string
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			name, ok := jsonFieldName(field)
+			if !ok {
+				continue
+			}
+			props[name] = schemaForType(field.Type)
+			if strings.Contains(field.Tag.Get("validate"), "required") {
+				required = append(required, name)
+			}
+		}
+		schema := map[string]any{"type": "object", "properties": props}
+		if len(required) > 0 {
+			schema["required"] = required
+		}
+		return schema
+	case reflect.Slice, reflect.Array:
+		return map[string]any{"type": "array", "items": schemaForType(t.Elem())}
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	default:
+		return map[string]any{"type": "object"}
+	}
+}
+
+func jsonFieldName(f reflect.StructField) (string, bool) {
+	tag := f.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+	name := strings.Split(tag, ",")[0]
+	if name == "" {
+		name = f.Name
+	}
+	return name, true
+}
+
+// swaggerUIHTML renders Swagger UI against /openapi.json via the
+// jsdelivr CDN, avoiding the need to vendor its static assets.
+const swaggerUIHTML = `<!DOCTYPE html>
+<html>
+<head>
+  <title>API Docs</title>
+  <link rel="stylesheet" href="https://cdn.jsdelivr.net/npm/swagger-ui-dist/swagger-ui.css" />
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://cdn.jsdelivr.net/npm/swagger-ui-dist/swagger-ui-bundle.js"></script>
+  <script>
+    window.onload = () => SwaggerUIBundle({url: '/openapi.json', dom_id: '#swagger-ui'})
+  </script>
+</body>
+</html>
+`
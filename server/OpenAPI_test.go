@@ -0,0 +1,122 @@
+package server
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type petStatus string
+
+const (
+	petStatusAvailable petStatus = "available"
+	petStatusSold      petStatus = "sold"
+)
+
+type address struct {
+	City string `json:"city"`
+	Zip  string `json:"zip" validate:"required"`
+}
+
+type petReq struct {
+	Name    string    `json:"name" validate:"required"`
+	Status  petStatus `json:"status"`
+	Tags    []string  `json:"tags"`
+	Address address   `json:"address"`
+}
+
+func TestSchemaForNestedStructSliceAndEnumString(t *testing.T) {
+	schema := schemaFor(petReq{})
+
+	if schema["type"] != "object" {
+		t.Fatalf("type = %v, want object", schema["type"])
+	}
+
+	props, ok := schema["properties"].(map[string]any)
+	if !ok {
+		t.Fatalf("properties is %T, want map[string]any", schema["properties"])
+	}
+
+	status, ok := props["status"].(map[string]any)
+	if !ok || status["type"] != "string" {
+		t.Fatalf("status schema = %v, want a string schema (enum-like named string type)", props["status"])
+	}
+
+	tags, ok := props["tags"].(map[string]any)
+	if !ok || tags["type"] != "array" {
+		t.Fatalf("tags schema = %v, want an array schema", props["tags"])
+	}
+	items, ok := tags["items"].(map[string]any)
+	if !ok || items["type"] != "string" {
+		t.Fatalf("tags.items schema = %v, want a string schema", tags["items"])
+	}
+
+	addr, ok := props["address"].(map[string]any)
+	if !ok || addr["type"] != "object" {
+		t.Fatalf("address schema = %v, want an object schema (nested struct)", props["address"])
+	}
+	addrProps, ok := addr["properties"].(map[string]any)
+	if !ok {
+		t.Fatalf("address.properties is %T, want map[string]any", addr["properties"])
+	}
+	if _, ok := addrProps["city"]; !ok {
+		t.Fatal("expected address.properties to include city")
+	}
+	required, _ := addr["required"].([]string)
+	if len(required) != 1 || required[0] != "zip" {
+		t.Fatalf("address.required = %v, want [zip]", required)
+	}
+}
+
+func TestToOpenAPIPathRewritesGinParams(t *testing.T) {
+	got := toOpenAPIPath("/users/:id/posts/:postID")
+	want := "/users/{id}/posts/{postID}"
+	if got != want {
+		t.Fatalf("toOpenAPIPath = %q, want %q", got, want)
+	}
+}
+
+func TestGenerateOpenAPIIncludesRequestAndResponseSchemas(t *testing.T) {
+	routes := []RouteConfig{
+		{
+			Path:            "/pets",
+			Method:          "POST",
+			Summary:         "Create a pet",
+			RequestExample:  petReq{},
+			ResponseExample: petReq{},
+		},
+	}
+
+	spec, err := GenerateOpenAPI(routes)
+	if err != nil {
+		t.Fatalf("GenerateOpenAPI: %v", err)
+	}
+	if len(spec) == 0 {
+		t.Fatal("expected a non-empty spec")
+	}
+
+	var doc map[string]any
+	if err := json.Unmarshal(spec, &doc); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	paths, _ := doc["paths"].(map[string]any)
+	pets, _ := paths["/pets"].(map[string]any)
+	post, ok := pets["post"].(map[string]any)
+	if !ok {
+		t.Fatalf("spec has no POST /pets operation: %s", spec)
+	}
+
+	reqBody, _ := post["requestBody"].(map[string]any)
+	reqContent, _ := reqBody["content"].(map[string]any)
+	reqJSON, _ := reqContent["application/json"].(map[string]any)
+	if _, ok := reqJSON["schema"].(map[string]any); !ok {
+		t.Fatalf("POST /pets has no application/json request schema: %s", spec)
+	}
+
+	responses, _ := post["responses"].(map[string]any)
+	resp200, _ := responses["200"].(map[string]any)
+	respContent, _ := resp200["content"].(map[string]any)
+	respJSON, _ := respContent["application/json"].(map[string]any)
+	if _, ok := respJSON["schema"].(map[string]any); !ok {
+		t.Fatalf("POST /pets has no application/json 200 response schema: %s", spec)
+	}
+}
@@ -0,0 +1,81 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestRegisterResolvedRoutesDispatchesPerTenant(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	srv, err := NewServer(&Config{Host: "127.0.0.1", Port: "0", Mode: gin.TestMode})
+	if err != nil {
+		t.Fatalf("NewServer: %v", err)
+	}
+
+	srv.RegisterResolvedRoutes(HeaderResolver{Header: "X-Tenant-ID"}, map[string][]RouteConfig{
+		"tenant-a": {{
+			Path:   "/greeting",
+			Method: http.MethodGet,
+			HandleFunc: func(c *gin.Context) {
+				c.String(http.StatusOK, "hello from a")
+			},
+		}},
+		"tenant-b": {{
+			Path:   "/greeting",
+			Method: http.MethodGet,
+			HandleFunc: func(c *gin.Context) {
+				c.String(http.StatusOK, "hello from b")
+			},
+		}},
+	})
+
+	engine := srv.Engine()
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/greeting", nil)
+	req.Header.Set("X-Tenant-ID", "tenant-a")
+	engine.ServeHTTP(rec, req)
+	if rec.Body.String() != "hello from a" {
+		t.Fatalf("tenant-a body = %q, want %q", rec.Body.String(), "hello from a")
+	}
+
+	rec = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodGet, "/greeting", nil)
+	req.Header.Set("X-Tenant-ID", "tenant-b")
+	engine.ServeHTTP(rec, req)
+	if rec.Body.String() != "hello from b" {
+		t.Fatalf("tenant-b body = %q, want %q", rec.Body.String(), "hello from b")
+	}
+}
+
+func TestRegisterResolvedRoutesFallsThroughWhenUnmatched(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	srv, err := NewServer(&Config{Host: "127.0.0.1", Port: "0", Mode: gin.TestMode})
+	if err != nil {
+		t.Fatalf("NewServer: %v", err)
+	}
+
+	srv.RegisterResolvedRoutes(HeaderResolver{Header: "X-Tenant-ID"}, map[string][]RouteConfig{
+		"tenant-a": {{
+			Path:       "/greeting",
+			Method:     http.MethodGet,
+			HandleFunc: func(c *gin.Context) { c.String(http.StatusOK, "hello from a") },
+		}},
+	})
+	// Registered after RegisterResolvedRoutes so the resolver middleware
+	// (installed via engine.Use) is part of this route's handler chain.
+	srv.RegisterRoute(http.MethodGet, "/greeting", func(c *gin.Context) {
+		c.String(http.StatusOK, "default")
+	})
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/greeting", nil)
+	srv.Engine().ServeHTTP(rec, req)
+
+	if rec.Body.String() != "default" {
+		t.Fatalf("body = %q, want %q (fall through to main engine)", rec.Body.String(), "default")
+	}
+}
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// ErrNoGroup is returned by a Resolver when no route group matches the
+// request; RegisterResolvedRoutes treats it as "fall through to the
+// main engine" rather than as a hard error.
+var ErrNoGroup = errors.New("server: no route group matched the request")
+
+// Resolver maps an incoming request to a route group key so
+// RegisterResolvedRoutes can dispatch it to the matching sub-engine.
+type Resolver interface {
+	Resolve(r *http.Request) (groupKey string, err error)
+}
+
+// HostResolver resolves the group key from the request's Host header,
+// stripped of any port, e.g. "tenant-a.example.com".
+type HostResolver struct{}
+
+func (HostResolver) Resolve(r *http.Request) (string, error) {
+	host := r.Host
+	if i := strings.IndexByte(host, ':'); i != -1 {
+		host = host[:i]
+	}
+	if host == "" {
+		return "", ErrNoGroup
+	}
+	return host, nil
+}
+
+// PathPrefixResolver resolves the group key as whichever of Prefixes
+// matches the start of the request path, e.g. "/tenant-a/rooms" ->
+// "tenant-a".
+type PathPrefixResolver struct {
+	Prefixes []string
+}
+
+func (p PathPrefixResolver) Resolve(r *http.Request) (string, error) {
+	for _, prefix := range p.Prefixes {
+		if r.URL.Path == "/"+prefix || strings.HasPrefix(r.URL.Path, "/"+prefix+"/") {
+			return prefix, nil
+		}
+	}
+	return "", ErrNoGroup
+}
+
+// HeaderResolver resolves the group key from a request header, e.g.
+// X-Tenant-ID.
+type HeaderResolver struct {
+	Header string
+}
+
+func (h HeaderResolver) Resolve(r *http.Request) (string, error) {
+	value := r.Header.Get(h.Header)
+	if value == "" {
+		return "", ErrNoGroup
+	}
+	return value, nil
+}
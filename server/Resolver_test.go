@@ -0,0 +1,82 @@
+package server
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHostResolverStripsPort(t *testing.T) {
+	req := httptest.NewRequest("GET", "/", nil)
+	req.Host = "tenant-a.example.com:8080"
+
+	key, err := HostResolver{}.Resolve(req)
+	if err != nil {
+		t.Fatalf("Resolve: %v", err)
+	}
+	if key != "tenant-a.example.com" {
+		t.Fatalf("key = %q, want %q", key, "tenant-a.example.com")
+	}
+}
+
+func TestHostResolverEmptyHostIsNoGroup(t *testing.T) {
+	req := httptest.NewRequest("GET", "/", nil)
+	req.Host = ""
+
+	if _, err := (HostResolver{}).Resolve(req); err != ErrNoGroup {
+		t.Fatalf("err = %v, want ErrNoGroup", err)
+	}
+}
+
+func TestPathPrefixResolverMatchesExactAndNested(t *testing.T) {
+	resolver := PathPrefixResolver{Prefixes: []string{"tenant-a", "tenant-b"}}
+
+	for _, tc := range []struct {
+		path string
+		want string
+	}{
+		{"/tenant-a", "tenant-a"},
+		{"/tenant-a/rooms", "tenant-a"},
+		{"/tenant-b/rooms/1", "tenant-b"},
+	} {
+		req := httptest.NewRequest("GET", tc.path, nil)
+		key, err := resolver.Resolve(req)
+		if err != nil {
+			t.Fatalf("Resolve(%q): %v", tc.path, err)
+		}
+		if key != tc.want {
+			t.Fatalf("Resolve(%q) = %q, want %q", tc.path, key, tc.want)
+		}
+	}
+}
+
+func TestPathPrefixResolverNoMatch(t *testing.T) {
+	resolver := PathPrefixResolver{Prefixes: []string{"tenant-a"}}
+	req := httptest.NewRequest("GET", "/tenant-ab/rooms", nil)
+
+	if _, err := resolver.Resolve(req); err != ErrNoGroup {
+		t.Fatalf("err = %v, want ErrNoGroup", err)
+	}
+}
+
+func TestHeaderResolverReadsConfiguredHeader(t *testing.T) {
+	resolver := HeaderResolver{Header: "X-Tenant-ID"}
+	req := httptest.NewRequest("GET", "/", nil)
+	req.Header.Set("X-Tenant-ID", "tenant-a")
+
+	key, err := resolver.Resolve(req)
+	if err != nil {
+		t.Fatalf("Resolve: %v", err)
+	}
+	if key != "tenant-a" {
+		t.Fatalf("key = %q, want %q", key, "tenant-a")
+	}
+}
+
+func TestHeaderResolverMissingHeaderIsNoGroup(t *testing.T) {
+	resolver := HeaderResolver{Header: "X-Tenant-ID"}
+	req := httptest.NewRequest("GET", "/", nil)
+
+	if _, err := resolver.Resolve(req); err != ErrNoGroup {
+		t.Fatalf("err = %v, want ErrNoGroup", err)
+	}
+}
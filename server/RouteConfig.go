@@ -7,4 +7,20 @@ type RouteConfig struct {
 	Method     string
 	HandleFunc func(c *gin.Context)
 	Middleware []gin.HandlerFunc
+
+	// Summary, Tags, RequestExample and ResponseExample are optional
+	// metadata consumed by GenerateOpenAPI; they have no effect on
+	// routing itself. RequestExample/ResponseExample should be a zero
+	// or example value of the Handler's Req/Resp type, used to derive
+	// the JSON schema.
+	Summary         string
+	Tags            []string
+	RequestExample  any
+	ResponseExample any
+
+	// ErrorStatus maps sentinel errors a typed Handler may return to
+	// the HTTP status documented for them in the generated spec. It
+	// does not affect the runtime mapping, which goes through
+	// HTTPError.
+	ErrorStatus map[error]int
 }
@@ -0,0 +1,52 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SSE adapts a streaming handler into a gin.HandlerFunc that serves
+// Server-Sent Events: it sets the event-stream headers, flushes after
+// every send, and stops when the client disconnects.
+//
+// Example:
+//
+//	server.RouteConfig{
+//	    Path:       "/stream/:roomid",
+//	    Method:     http.MethodGet,
+//	    HandleFunc: server.SSE(roomHandler.Stream),
+//	}
+func SSE(handler func(ctx context.Context, send func(event string, data any) error) error) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Writer.Header().Set("Content-Type", "text/event-stream")
+		c.Writer.Header().Set("Cache-Control", "no-cache")
+		c.Writer.Header().Set("Connection", "keep-alive")
+
+		flusher, ok := c.Writer.(http.Flusher)
+		if !ok {
+			c.String(http.StatusInternalServerError, "streaming unsupported")
+			return
+		}
+
+		send := func(event string, data any) error {
+			payload, err := json.Marshal(data)
+			if err != nil {
+				return err
+			}
+			if event != "" {
+				fmt.Fprintf(c.Writer, "event: %s\n", event)
+			}
+			fmt.Fprintf(c.Writer, "data: %s\n\n", payload)
+			flusher.Flush()
+			return nil
+		}
+
+		if err := handler(c.Request.Context(), send); err != nil && c.Request.Context().Err() == nil {
+			_ = send("error", gin.H{"error": err.Error()})
+		}
+	}
+}
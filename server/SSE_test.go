@@ -0,0 +1,44 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestSSESendsEventsAndSetsHeaders(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	engine.GET("/stream", SSE(func(ctx context.Context, send func(event string, data any) error) error {
+		if err := send("greeting", map[string]string{"msg": "hi"}); err != nil {
+			return err
+		}
+		return send("", map[string]string{"msg": "bye"})
+	}))
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/stream", nil)
+	engine.ServeHTTP(rec, req)
+
+	if ct := rec.Header().Get("Content-Type"); ct != "text/event-stream" {
+		t.Fatalf("Content-Type = %q, want text/event-stream", ct)
+	}
+	if cc := rec.Header().Get("Cache-Control"); cc != "no-cache" {
+		t.Fatalf("Cache-Control = %q, want no-cache", cc)
+	}
+
+	body := rec.Body.String()
+	if !strings.Contains(body, "event: greeting") {
+		t.Fatalf("body = %q, want it to contain the greeting event name", body)
+	}
+	if !strings.Contains(body, `data: {"msg":"hi"}`) {
+		t.Fatalf("body = %q, want it to contain the greeting payload", body)
+	}
+	if !strings.Contains(body, `data: {"msg":"bye"}`) {
+		t.Fatalf("body = %q, want it to contain the unnamed event payload", body)
+	}
+}
@@ -2,10 +2,18 @@ package server
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"net"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/kimxuanhong/go-http/server/middleware"
 )
 
 // Server defines server operations.
@@ -18,40 +26,109 @@ type Server interface {
 	RegisterPrivateRoutes(register func(rg *gin.RouterGroup), middleware ...gin.HandlerFunc)
 	RegisterRoute(method, path string, handler gin.HandlerFunc)
 	Routes(routes []RouteConfig)
+	// RegisterGRPCService registers a gRPC service implementation on the
+	// server's gRPC endpoint. It is a no-op if Config.GRPC was not set.
+	RegisterGRPCService(desc *grpc.ServiceDesc, impl any)
+	// GatewayMux returns the grpc-gateway mux so REST handlers generated
+	// from gRPC services can be mounted into the Gin engine. It returns
+	// nil if Config.GRPC was not set.
+	GatewayMux() *runtime.ServeMux
+	// ServeOpenAPI generates an OpenAPI spec from routes and mounts it
+	// at GET /openapi.json, plus a Swagger UI at GET /docs.
+	ServeOpenAPI(routes []RouteConfig) error
+	// RegisterResolvedRoutes installs route groups dispatched per
+	// request by resolver, for multi-tenant/multi-vhost deployments.
+	RegisterResolvedRoutes(resolver Resolver, groups map[string][]RouteConfig)
 }
 
 type server struct {
 	engine     *gin.Engine
 	config     *Config
 	httpServer *http.Server
+
+	grpcServer *grpc.Server
+	gatewayMux *runtime.ServeMux
 }
 
-// NewServer initializes and returns a new Server instance.
+// NewServer initializes and returns a new Server instance. It returns
+// an error if Config.GRPC is set with a TLS cert/key pair that fails
+// to load, rather than silently falling back to a plaintext endpoint.
 //
 // Example:
 //
 //	cfg := config.NewServerConfig()
-//	srv := server.NewServer(cfg)
+//	srv, err := server.NewServer(cfg)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
 //	srv.RegisterRoutes(func(rg *gin.RouterGroup) {
 //	    rg.GET("/ping", func(c *gin.Context) { c.JSON(200, gin.H{"message": "pong"}) })
 //	})
 //	if err := srv.Start(); err != nil {
 //	    log.Fatal(err)
 //	}
-func NewServer(cfg *Config) Server {
+func NewServer(cfg *Config) (Server, error) {
 	gin.SetMode(cfg.Mode)
 
 	engine := gin.New()
-	engine.Use(gin.Logger())
-	engine.Use(gin.Recovery())
+	engine.Use(defaultMiddleware(cfg.DefaultMiddleware)...)
 
-	return &server{
+	s := &server{
 		engine: engine,
 		config: cfg,
 	}
+
+	if cfg.GRPC != nil {
+		grpcServer, err := newGRPCServer(cfg.GRPC)
+		if err != nil {
+			return nil, err
+		}
+		s.grpcServer = grpcServer
+		s.gatewayMux = runtime.NewServeMux()
+	}
+
+	return s, nil
+}
+
+// defaultMiddleware builds the engine-wide stack from Config's
+// DefaultMiddleware toggle, falling back to the historical
+// gin.Logger()+gin.Recovery() pair when it's unset.
+func defaultMiddleware(names []string) []gin.HandlerFunc {
+	if len(names) == 0 {
+		return []gin.HandlerFunc{gin.Logger(), gin.Recovery()}
+	}
+
+	stack := make([]gin.HandlerFunc, 0, len(names))
+	for _, name := range names {
+		switch name {
+		case "logger":
+			stack = append(stack, gin.Logger())
+		case "recovery":
+			stack = append(stack, gin.Recovery())
+		case "request_id":
+			stack = append(stack, middleware.RequestID())
+		default:
+			log.Printf("Unknown default middleware: %s", name)
+		}
+	}
+	return stack
 }
 
-// Start runs the HTTP server.
+func newGRPCServer(cfg *GRPCConfig) (*grpc.Server, error) {
+	opts := cfg.ServerOptions
+	if cfg.TLSCertFile != "" && cfg.TLSKeyFile != "" {
+		creds, err := credentials.NewServerTLSFromFile(cfg.TLSCertFile, cfg.TLSKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("gRPC TLS: failed to load credentials: %w", err)
+		}
+		opts = append(opts, grpc.Creds(creds))
+	}
+	return grpc.NewServer(opts...), nil
+}
+
+// Start runs the HTTP server and, when Config.GRPC is set, the gRPC
+// server concurrently. Either transport exiting or failing tears down
+// the other.
 //
 // Example:
 //
@@ -59,17 +136,43 @@ func NewServer(cfg *Config) Server {
 //	    log.Fatal(err)
 //	}
 func (s *server) Start() error {
-	addr := s.config.GetAddr()
 	s.httpServer = &http.Server{
-		Addr:    addr,
+		Addr:    s.config.GetAddr(),
 		Handler: s.engine,
 	}
 
-	log.Printf("Server is running at %s", addr)
+	if s.grpcServer == nil {
+		return s.startHTTP()
+	}
+
+	group, ctx := errgroup.WithContext(context.Background())
+
+	group.Go(s.startHTTP)
+
+	group.Go(func() error {
+		lis, err := net.Listen("tcp", s.config.GRPC.GetAddr())
+		if err != nil {
+			return err
+		}
+		log.Printf("gRPC server is running at %s", s.config.GRPC.GetAddr())
+		return s.grpcServer.Serve(lis)
+	})
+
+	group.Go(func() error {
+		<-ctx.Done()
+		return s.Shutdown(context.Background())
+	})
+
+	return group.Wait()
+}
+
+func (s *server) startHTTP() error {
+	log.Printf("Server is running at %s", s.httpServer.Addr)
 	return s.httpServer.ListenAndServe()
 }
 
-// Shutdown gracefully shuts down the server with context.
+// Shutdown gracefully shuts down the HTTP server and, if enabled, the
+// gRPC server.
 //
 // Example:
 //
@@ -80,12 +183,67 @@ func (s *server) Start() error {
 //	}
 func (s *server) Shutdown(ctx context.Context) error {
 	log.Println("Shutting down server...")
+
+	if s.grpcServer != nil {
+		s.grpcServer.GracefulStop()
+	}
+
 	if s.httpServer == nil {
 		return nil
 	}
 	return s.httpServer.Shutdown(ctx)
 }
 
+// RegisterGRPCService registers a gRPC service implementation on the
+// server's gRPC endpoint. It is a no-op if Config.GRPC was not set.
+//
+// Example:
+//
+//	srv.RegisterGRPCService(&pb.GreeterService_ServiceDesc, &greeterImpl{})
+func (s *server) RegisterGRPCService(desc *grpc.ServiceDesc, impl any) {
+	if s.grpcServer == nil {
+		log.Println("RegisterGRPCService called without Config.GRPC set, ignoring")
+		return
+	}
+	s.grpcServer.RegisterService(desc, impl)
+}
+
+// GatewayMux returns the grpc-gateway mux so REST handlers generated
+// from gRPC services can be mounted into the Gin engine for dual
+// REST/gRPC serving. It returns nil if Config.GRPC was not set.
+//
+// Example:
+//
+//	srv.Engine().Any("/v1/*any", gin.WrapH(srv.GatewayMux()))
+func (s *server) GatewayMux() *runtime.ServeMux {
+	return s.gatewayMux
+}
+
+// ServeOpenAPI generates an OpenAPI spec from routes and mounts it at
+// GET /openapi.json, plus a Swagger UI at GET /docs.
+//
+// Example:
+//
+//	srv.Routes(routes)
+//	if err := srv.ServeOpenAPI(routes); err != nil {
+//	    log.Fatal(err)
+//	}
+func (s *server) ServeOpenAPI(routes []RouteConfig) error {
+	spec, err := GenerateOpenAPI(routes)
+	if err != nil {
+		return err
+	}
+
+	s.engine.GET("/openapi.json", func(c *gin.Context) {
+		c.Data(http.StatusOK, "application/json", spec)
+	})
+	s.engine.GET("/docs", func(c *gin.Context) {
+		c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(swaggerUIHTML))
+	})
+
+	return nil
+}
+
 // Engine returns the underlying *gin.Engine.
 //
 // Example:
@@ -138,17 +296,21 @@ func (s *server) RegisterPrivateRoutes(register func(rg *gin.RouterGroup), middl
 //	    c.JSON(200, gin.H{"message": "pong"})
 //	})
 func (s *server) RegisterRoute(method, path string, handler gin.HandlerFunc) {
+	registerRoute(s.engine, method, path, handler)
+}
+
+func registerRoute(engine *gin.Engine, method, path string, handler gin.HandlerFunc) {
 	switch method {
 	case "GET":
-		s.engine.GET(path, handler)
+		engine.GET(path, handler)
 	case "POST":
-		s.engine.POST(path, handler)
+		engine.POST(path, handler)
 	case "PUT":
-		s.engine.PUT(path, handler)
+		engine.PUT(path, handler)
 	case "PATCH":
-		s.engine.PATCH(path, handler)
+		engine.PATCH(path, handler)
 	case "DELETE":
-		s.engine.DELETE(path, handler)
+		engine.DELETE(path, handler)
 	default:
 		log.Printf("Unsupported method: %s", method)
 	}
@@ -184,3 +346,49 @@ func (s *server) Routes(routes []RouteConfig) {
 		s.RegisterRoute(r.Method, r.Path, r.HandleFunc)
 	}
 }
+
+// RegisterResolvedRoutes builds one sub-engine per group in groups and
+// installs a root middleware that dispatches each request to its
+// group's engine based on resolver, enabling multi-tenant deployments
+// where the same binary serves different route sets per host, path
+// prefix or header. Requests resolver can't match fall through to the
+// server's main engine.
+//
+// Example:
+//
+//	srv.RegisterResolvedRoutes(server.HostResolver{}, map[string][]server.RouteConfig{
+//	    "tenant-a.example.com": tenantARoutes,
+//	    "tenant-b.example.com": tenantBRoutes,
+//	})
+func (s *server) RegisterResolvedRoutes(resolver Resolver, groups map[string][]RouteConfig) {
+	engines := make(map[string]*gin.Engine, len(groups))
+	for key, routes := range groups {
+		engine := gin.New()
+		engine.Use(defaultMiddleware(s.config.DefaultMiddleware)...)
+
+		for _, r := range routes {
+			group := engine.Group(r.Path)
+			group.Use(r.Middleware...)
+			registerRoute(engine, r.Method, r.Path, r.HandleFunc)
+		}
+
+		engines[key] = engine
+	}
+
+	s.engine.Use(func(c *gin.Context) {
+		key, err := resolver.Resolve(c.Request)
+		if err != nil {
+			c.Next()
+			return
+		}
+
+		engine, ok := engines[key]
+		if !ok {
+			c.Next()
+			return
+		}
+
+		engine.ServeHTTP(c.Writer, c.Request)
+		c.Abort()
+	})
+}
@@ -0,0 +1,92 @@
+package server
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestServerStartShutdownRespectsContext(t *testing.T) {
+	srv, err := NewServer(&Config{Host: "127.0.0.1", Port: "0", Mode: "test"})
+	if err != nil {
+		t.Fatalf("NewServer: %v", err)
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- srv.Start() }()
+	time.Sleep(50 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := srv.Shutdown(ctx); err != nil {
+		t.Fatalf("Shutdown: %v", err)
+	}
+
+	select {
+	case err := <-done:
+		if err != nil && err != http.ErrServerClosed {
+			t.Fatalf("Start returned unexpected error: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Start did not return after Shutdown")
+	}
+}
+
+// TestServerStartTearsDownHTTPOnGRPCFailure reserves the gRPC port so
+// the gRPC listener fails immediately, then asserts Start() still
+// returns promptly instead of hanging on the HTTP side -- the race
+// this test guards against previously let the watchdog observe a nil
+// httpServer and skip its shutdown.
+func TestServerStartTearsDownHTTPOnGRPCFailure(t *testing.T) {
+	reserved, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("reserve port: %v", err)
+	}
+	defer reserved.Close()
+
+	_, port, err := net.SplitHostPort(reserved.Addr().String())
+	if err != nil {
+		t.Fatalf("split addr: %v", err)
+	}
+
+	srv, err := NewServer(&Config{
+		Host: "127.0.0.1",
+		Port: "0",
+		Mode: "test",
+		GRPC: &GRPCConfig{Host: "127.0.0.1", Port: port},
+	})
+	if err != nil {
+		t.Fatalf("NewServer: %v", err)
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- srv.Start() }()
+
+	select {
+	case err := <-done:
+		if err == nil {
+			t.Fatal("expected Start to return an error when the gRPC port is taken")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Start did not tear down after the gRPC listener failed")
+	}
+}
+
+func TestNewServerFailsOnBadGRPCTLS(t *testing.T) {
+	_, err := NewServer(&Config{
+		Host: "127.0.0.1",
+		Port: "0",
+		Mode: "test",
+		GRPC: &GRPCConfig{
+			Host:        "127.0.0.1",
+			Port:        "0",
+			TLSCertFile: "/nonexistent/cert.pem",
+			TLSKeyFile:  "/nonexistent/key.pem",
+		},
+	})
+	if err == nil {
+		t.Fatal("expected NewServer to fail closed on an unloadable TLS cert/key pair")
+	}
+}
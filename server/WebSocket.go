@@ -0,0 +1,140 @@
+package server
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	defaultPongWait  = 60 * time.Second
+	defaultWriteWait = 10 * time.Second
+)
+
+// Conn wraps a *websocket.Conn and serializes writes across goroutines.
+// gorilla/websocket forbids more than one concurrent writer per
+// connection, and WebSocket's keepalive ping loop writes to the
+// connection from its own goroutine alongside the handler, so all
+// writes must go through Conn rather than the embedded connection
+// directly.
+type Conn struct {
+	*websocket.Conn
+	mu sync.Mutex
+}
+
+// WriteMessage writes a message to the connection, guarding against
+// concurrent writes from the keepalive ping loop.
+func (c *Conn) WriteMessage(messageType int, data []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.Conn.WriteMessage(messageType, data)
+}
+
+// WriteJSON writes v as a JSON message, guarding against concurrent
+// writes from the keepalive ping loop.
+func (c *Conn) WriteJSON(v interface{}) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.Conn.WriteJSON(v)
+}
+
+// ping sets the write deadline and sends a ping frame as a single
+// critical section, so it can't interleave with a concurrent
+// WriteMessage/WriteJSON call from handler.
+func (c *Conn) ping(writeWait time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.Conn.SetWriteDeadline(time.Now().Add(writeWait)); err != nil {
+		return err
+	}
+	return c.Conn.WriteMessage(websocket.PingMessage, nil)
+}
+
+// WebSocketConfig configures the upgrader and keepalive timers used by
+// WebSocket. The zero value is usable and only allows same-origin
+// requests.
+type WebSocketConfig struct {
+	// CheckOrigin validates the request's Origin header. Defaults to
+	// allowing same-origin requests only.
+	CheckOrigin func(r *http.Request) bool
+
+	ReadBufferSize  int
+	WriteBufferSize int
+
+	// PongWait is how long a connection may stay idle before being
+	// considered dead. PingPeriod is derived from it.
+	PongWait  time.Duration
+	WriteWait time.Duration
+}
+
+// WebSocket adapts a handler operating on an upgraded *Conn into a
+// gin.HandlerFunc. It upgrades the connection, applies read/write
+// deadlines and a ping/pong keepalive loop around handler, and closes
+// the connection once handler returns.
+//
+// Example:
+//
+//	server.RouteConfig{
+//	    Path:       "/ws",
+//	    Method:     http.MethodGet,
+//	    HandleFunc: server.WebSocket(chatHandler.Handle, server.WebSocketConfig{}),
+//	}
+func WebSocket(handler func(conn *Conn) error, cfg WebSocketConfig) gin.HandlerFunc {
+	pongWait := cfg.PongWait
+	if pongWait == 0 {
+		pongWait = defaultPongWait
+	}
+	writeWait := cfg.WriteWait
+	if writeWait == 0 {
+		writeWait = defaultWriteWait
+	}
+	pingPeriod := pongWait * 9 / 10
+
+	upgrader := websocket.Upgrader{
+		ReadBufferSize:  cfg.ReadBufferSize,
+		WriteBufferSize: cfg.WriteBufferSize,
+		CheckOrigin:     cfg.CheckOrigin,
+	}
+
+	return func(c *gin.Context) {
+		raw, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+		if err != nil {
+			return
+		}
+		conn := &Conn{Conn: raw}
+		defer conn.Close()
+
+		_ = conn.SetReadDeadline(time.Now().Add(pongWait))
+		conn.SetPongHandler(func(string) error {
+			return conn.SetReadDeadline(time.Now().Add(pongWait))
+		})
+
+		done := make(chan struct{})
+		defer close(done)
+		go keepAlive(conn, pingPeriod, writeWait, done)
+
+		_ = handler(conn)
+	}
+}
+
+// keepAlive sends a ping on every tick until done is closed or a write
+// fails. It does not close the connection itself; that happens in the
+// caller's deferred conn.Close() once handler returns.
+func keepAlive(conn *Conn, period, writeWait time.Duration, done <-chan struct{}) {
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			if err := conn.ping(writeWait); err != nil {
+				return
+			}
+		}
+	}
+}
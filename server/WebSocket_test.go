@@ -0,0 +1,52 @@
+package server
+
+import (
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+// TestWebSocketConcurrentHandlerWritesDontRace exercises the scenario
+// the documented chat-handler use case relies on: the handler writes
+// to the connection from multiple goroutines at the same time while
+// the keepalive loop is running. With a 200ms PongWait the first ping
+// is due after 180ms, so the writes that overlap here are the
+// handler's own. Run with -race to confirm Conn serializes them
+// instead of corrupting the connection.
+func TestWebSocketConcurrentHandlerWritesDontRace(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	engine.GET("/ws", WebSocket(func(conn *Conn) error {
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func(n int) {
+				defer wg.Done()
+				_ = conn.WriteMessage(websocket.TextMessage, []byte("msg"))
+			}(i)
+		}
+		wg.Wait()
+		return nil
+	}, WebSocketConfig{PongWait: 200 * time.Millisecond, WriteWait: 50 * time.Millisecond}))
+
+	srv := httptest.NewServer(engine)
+	defer srv.Close()
+
+	url := "ws" + strings.TrimPrefix(srv.URL, "http") + "/ws"
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer conn.Close()
+
+	for i := 0; i < 10; i++ {
+		if _, _, err := conn.ReadMessage(); err != nil {
+			t.Fatalf("ReadMessage: %v", err)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+type testClaims struct {
+	jwt.RegisteredClaims
+	UserID string `json:"user_id"`
+}
+
+func jwtTestEngine(secret []byte) (*gin.Engine, *string) {
+	engine := newTestEngine()
+	var seenUserID string
+	engine.Use(JWTAuth[*testClaims](secret, jwt.SigningMethodHS256))
+	engine.GET("/private", func(c *gin.Context) {
+		claims, _ := ClaimsFrom[*testClaims](c)
+		seenUserID = claims.UserID
+		c.Status(http.StatusOK)
+	})
+	return engine, &seenUserID
+}
+
+func TestJWTAuthAcceptsValidToken(t *testing.T) {
+	secret := []byte("super-secret")
+	engine, userID := jwtTestEngine(secret)
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, testClaims{UserID: "u1"})
+	signed, err := token.SignedString(secret)
+	if err != nil {
+		t.Fatalf("SignedString: %v", err)
+	}
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/private", nil)
+	req.Header.Set("Authorization", "Bearer "+signed)
+	engine.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if *userID != "u1" {
+		t.Fatalf("user_id = %q, want %q", *userID, "u1")
+	}
+}
+
+func TestJWTAuthRejectsMismatchedAlgorithm(t *testing.T) {
+	secret := []byte("super-secret")
+	engine, _ := jwtTestEngine(secret)
+
+	// Signed with HS384 while the middleware only accepts HS256.
+	token := jwt.NewWithClaims(jwt.SigningMethodHS384, testClaims{UserID: "u1"})
+	signed, err := token.SignedString(secret)
+	if err != nil {
+		t.Fatalf("SignedString: %v", err)
+	}
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/private", nil)
+	req.Header.Set("Authorization", "Bearer "+signed)
+	engine.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+}
+
+func TestJWTAuthRejectsMissingHeader(t *testing.T) {
+	engine, _ := jwtTestEngine([]byte("super-secret"))
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/private", nil)
+	engine.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+}
@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Recovery catches panics in downstream handlers and invokes onPanic
+// instead of the default stack-dump-to-stdout behavior, so production
+// can forward the panic to Sentry/alerts while dev keeps the dump via
+// gin.Recovery.
+//
+// Example:
+//
+//	srv.RegisterMiddleware(middleware.Recovery(func(c *gin.Context, err any) {
+//	    sentry.CaptureException(fmt.Errorf("%v", err))
+//	    c.AbortWithStatus(http.StatusInternalServerError)
+//	}))
+func Recovery(onPanic func(c *gin.Context, err any)) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		defer func() {
+			if err := recover(); err != nil {
+				if onPanic != nil {
+					onPanic(c, err)
+				}
+				if !c.IsAborted() {
+					c.AbortWithStatus(http.StatusInternalServerError)
+				}
+			}
+		}()
+		c.Next()
+	}
+}
@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestRecoveryInvokesOnPanic(t *testing.T) {
+	engine := newTestEngine()
+	var captured any
+	engine.Use(Recovery(func(c *gin.Context, err any) {
+		captured = err
+	}))
+	engine.GET("/boom", func(c *gin.Context) {
+		panic("kaboom")
+	})
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/boom", nil)
+	engine.ServeHTTP(rec, req)
+
+	if captured != "kaboom" {
+		t.Fatalf("onPanic received %v, want %q", captured, "kaboom")
+	}
+	if rec.Code != http.StatusInternalServerError {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusInternalServerError)
+	}
+}
+
+func TestRecoveryRespectsHandlerAbort(t *testing.T) {
+	engine := newTestEngine()
+	engine.Use(Recovery(func(c *gin.Context, err any) {
+		c.AbortWithStatus(http.StatusTeapot)
+	}))
+	engine.GET("/boom", func(c *gin.Context) {
+		panic("kaboom")
+	})
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/boom", nil)
+	engine.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusTeapot {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusTeapot)
+	}
+}
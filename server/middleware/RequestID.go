@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// HeaderRequestID is the header read from and written to requests/responses.
+const HeaderRequestID = "X-Request-ID"
+
+type requestIDKey struct{}
+
+// RequestID reads X-Request-ID from the incoming request, generating a
+// UUID when absent, echoes it on the response, and injects it into
+// c.Request.Context() so it can be retrieved with RequestIDFromContext.
+//
+// Example:
+//
+//	srv.RegisterMiddleware(middleware.RequestID())
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(HeaderRequestID)
+		if id == "" {
+			id = uuid.NewString()
+		}
+
+		c.Writer.Header().Set(HeaderRequestID, id)
+		ctx := context.WithValue(c.Request.Context(), requestIDKey{}, id)
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+	}
+}
+
+// RequestIDFromContext returns the request id injected by RequestID, or
+// "" if it was never set.
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
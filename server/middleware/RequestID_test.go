@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func newTestEngine() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	return gin.New()
+}
+
+func TestRequestIDGeneratesWhenAbsent(t *testing.T) {
+	engine := newTestEngine()
+	var seen string
+	engine.Use(RequestID())
+	engine.GET("/ping", func(c *gin.Context) {
+		seen = RequestIDFromContext(c.Request.Context())
+		c.Status(http.StatusOK)
+	})
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/ping", nil)
+	engine.ServeHTTP(rec, req)
+
+	if seen == "" {
+		t.Fatal("expected a generated request id in the context")
+	}
+	if rec.Header().Get(HeaderRequestID) != seen {
+		t.Fatalf("response header %q = %q, want %q", HeaderRequestID, rec.Header().Get(HeaderRequestID), seen)
+	}
+}
+
+func TestRequestIDEchoesIncomingHeader(t *testing.T) {
+	engine := newTestEngine()
+	engine.Use(RequestID())
+	engine.GET("/ping", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/ping", nil)
+	req.Header.Set(HeaderRequestID, "fixed-id")
+	engine.ServeHTTP(rec, req)
+
+	if got := rec.Header().Get(HeaderRequestID); got != "fixed-id" {
+		t.Fatalf("response header %q = %q, want %q", HeaderRequestID, got, "fixed-id")
+	}
+}
@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestStructuredLoggerRecordsRequestFields(t *testing.T) {
+	engine := newTestEngine()
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	engine.Use(RequestID())
+	engine.Use(StructuredLogger(logger))
+	engine.GET("/ping", func(c *gin.Context) {
+		c.Status(http.StatusTeapot)
+	})
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/ping", nil)
+	req.Header.Set(HeaderRequestID, "fixed-id")
+	engine.ServeHTTP(rec, req)
+
+	var record map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+		t.Fatalf("json.Unmarshal(%q): %v", buf.String(), err)
+	}
+
+	if got := record["method"]; got != http.MethodGet {
+		t.Fatalf("method = %v, want %q", got, http.MethodGet)
+	}
+	if got := record["path"]; got != "/ping" {
+		t.Fatalf("path = %v, want %q", got, "/ping")
+	}
+	if got := record["status"]; got != float64(http.StatusTeapot) {
+		t.Fatalf("status = %v, want %d", got, http.StatusTeapot)
+	}
+	if _, ok := record["latency"]; !ok {
+		t.Fatal("expected a latency field")
+	}
+	if got := record["request_id"]; got != "fixed-id" {
+		t.Fatalf("request_id = %v, want %q", got, "fixed-id")
+	}
+	if _, ok := record["client_ip"]; !ok {
+		t.Fatal("expected a client_ip field")
+	}
+}